@@ -0,0 +1,112 @@
+// Package client implements the low-level HTTP client every API package in
+// go-anxcloud uses to talk to the Anexia Engine API.
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+)
+
+const (
+	defaultBaseURL = "https://engine.anexia-it.com"
+	tokenEnvName   = "ANEXIA_TOKEN"
+)
+
+// Client executes requests against the Anexia Engine API.
+type Client interface {
+	// Do executes the given request, adding authentication before sending it.
+	Do(req *http.Request) (*http.Response, error)
+
+	// BaseURL returns the base URL API packages build their request URLs from.
+	BaseURL() string
+}
+
+type client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+
+	middlewares []Middleware
+	do          RoundTripper
+}
+
+// Option configures a Client created with New.
+type Option func(*client) error
+
+// New creates a new Client configured by the given options. At least one
+// option has to configure a token, e.g. TokenFromString or AuthFromEnv.
+//
+// WithRetry, WithRateLimit, WithRequestTimeout and WithMiddleware can be
+// given to layer retry/backoff, rate limiting, per-request deadlines or
+// custom behavior around every request the Client makes, without any API
+// package having to change its call sites.
+func New(opts ...Option) (Client, error) {
+	c := &client{
+		baseURL:    defaultBaseURL,
+		httpClient: &http.Client{},
+	}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, fmt.Errorf("could not apply client option: %w", err)
+		}
+	}
+
+	if c.token == "" {
+		return nil, fmt.Errorf("client: no authentication token configured")
+	}
+
+	c.do = c.roundTrip
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		c.do = c.middlewares[i](c.do)
+	}
+
+	return c, nil
+}
+
+// TokenFromString configures the Client to authenticate with the given token.
+func TokenFromString(token string) Option {
+	return func(c *client) error {
+		c.token = token
+		return nil
+	}
+}
+
+// AuthFromEnv configures the Client to authenticate with the token found in
+// the ANEXIA_TOKEN environment variable. If failOnError is true, New returns
+// an error when the variable is not set or empty.
+func AuthFromEnv(failOnError bool) Option {
+	return func(c *client) error {
+		token, ok := os.LookupEnv(tokenEnvName)
+		if !ok && failOnError {
+			return fmt.Errorf("environment variable %q not set", tokenEnvName)
+		}
+		c.token = token
+		return nil
+	}
+}
+
+// BaseURL configures the Client to use the given base URL instead of the
+// default Anexia Engine API endpoint.
+func BaseURL(url string) Option {
+	return func(c *client) error {
+		c.baseURL = url
+		return nil
+	}
+}
+
+func (c *client) BaseURL() string {
+	return c.baseURL
+}
+
+func (c *client) Do(req *http.Request) (*http.Response, error) {
+	return c.do(req)
+}
+
+// roundTrip is the innermost RoundTripper: it authenticates the request and
+// sends it using the configured http.Client. Every Middleware wraps this.
+func (c *client) roundTrip(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", "Token "+c.token)
+	return c.httpClient.Do(req)
+}
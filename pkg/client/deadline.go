@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// WithRequestTimeout bounds every request to at most timeout, deriving a
+// child context from the request's own context. This guarantees that a
+// request aborts its in-flight read and the underlying connection is
+// released back to the transport instead of blocking, or leaking a socket,
+// on a server that stops responding mid-stream, even if the caller's own
+// context carries no deadline.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return WithMiddleware(func(next RoundTripper) RoundTripper {
+		return func(req *http.Request) (*http.Response, error) {
+			ctx, cancel := context.WithTimeout(req.Context(), timeout)
+			req = req.WithContext(ctx)
+
+			resp, err := next(req)
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+
+			// cancel must not fire until the caller is done reading the
+			// response, so it's tied to Body.Close rather than run here.
+			// The deadline still aborts a stalled read: the transport
+			// cancels the in-flight request when ctx expires, which
+			// unblocks Read with an error and closes the connection.
+			resp.Body = &deadlineBody{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+	})
+}
+
+// deadlineBody ties a context.CancelFunc to a response body's lifetime, so
+// the context it belongs to is always canceled once the caller is done
+// reading, instead of staying alive until the timeout fires.
+type deadlineBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *deadlineBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
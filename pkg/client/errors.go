@@ -0,0 +1,15 @@
+package client
+
+import (
+	"net/http"
+
+	clienterrors "github.com/anexia-it/go-anxcloud/pkg/client/errors"
+)
+
+// DecodeError parses a non-2xx resp into a typed *errors.APIError from
+// pkg/client/errors, or returns nil if resp indicates success. The response
+// body is consumed and closed only when an error is returned; on success it
+// is left for the caller to read and close.
+func DecodeError(resp *http.Response) error {
+	return clienterrors.Decode(resp)
+}
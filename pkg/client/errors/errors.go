@@ -0,0 +1,90 @@
+// Package errors defines the typed errors API packages return for non-2xx
+// responses, so callers can use errors.Is and errors.As instead of matching
+// on wrapped error strings.
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Sentinel errors an APIError can be compared against with errors.Is,
+// grouped by the class of problem they represent rather than one exact
+// status code.
+var (
+	ErrNotFound     = errors.New("resource not found")
+	ErrConflict     = errors.New("resource conflict")
+	ErrUnauthorized = errors.New("not authorized")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrValidation   = errors.New("validation failed")
+)
+
+// APIError describes a non-2xx response from the Anexia Engine API.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	RequestID  string
+	Raw        []byte
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("api error (status %d): %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("api error (status %d)", e.StatusCode)
+}
+
+// Is lets errors.Is(err, ErrNotFound) and friends match an APIError by the
+// class of status code it carries, without callers needing to inspect
+// StatusCode themselves.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	default:
+		return false
+	}
+}
+
+// envelope is the JSON error body the Anexia Engine API returns.
+type envelope struct {
+	Code      string `json:"error_code"`
+	Message   string `json:"error"`
+	RequestID string `json:"request_id"`
+}
+
+// Decode reads resp's body and returns an *APIError describing it, or nil if
+// resp's status code is below 300. For a status code of 300 or above the
+// body is consumed and closed, so callers must not read it afterwards; below
+// 300 the body is left untouched for the caller to read and close.
+func Decode(resp *http.Response) error {
+	if resp.StatusCode < 300 {
+		return nil
+	}
+
+	raw, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	apiErr := &APIError{StatusCode: resp.StatusCode, Raw: raw}
+
+	var env envelope
+	if err := json.Unmarshal(raw, &env); err == nil {
+		apiErr.Code = env.Code
+		apiErr.Message = env.Message
+		apiErr.RequestID = env.RequestID
+	}
+
+	return apiErr
+}
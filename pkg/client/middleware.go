@@ -0,0 +1,21 @@
+package client
+
+import "net/http"
+
+// RoundTripper sends a single HTTP request and returns its response, the way
+// a Client's Do method does.
+type RoundTripper func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTripper with additional behavior, such as retries
+// or rate limiting, calling next to continue the chain.
+type Middleware func(next RoundTripper) RoundTripper
+
+// WithMiddleware adds a Middleware to the chain wrapping every request a
+// Client makes. Middlewares are applied in the order they are given to New:
+// the first one given is the outermost and sees a request first.
+func WithMiddleware(mw Middleware) Option {
+	return func(c *client) error {
+		c.middlewares = append(c.middlewares, mw)
+		return nil
+	}
+}
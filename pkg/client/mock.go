@@ -0,0 +1,25 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+)
+
+// NewMock creates a Client that sends every request to handler in-process,
+// via httptest, instead of over the network. This lets unit tests for API
+// packages exercise real request building and response decoding without a
+// cassette file or live credentials. The returned close func shuts down the
+// underlying httptest.Server and must be called once the Client is no
+// longer needed, e.g. via t.Cleanup.
+func NewMock(handler http.Handler) (Client, func()) {
+	server := httptest.NewServer(handler)
+
+	c := &client{
+		baseURL:    server.URL,
+		token:      "mock",
+		httpClient: server.Client(),
+	}
+	c.do = c.roundTrip
+
+	return c, server.Close
+}
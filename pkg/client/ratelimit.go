@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WithRateLimit limits outgoing requests to rps requests per second, with
+// bursts of up to burst requests allowed to exceed that rate momentarily.
+// The limiter is shared by every goroutine using the Client. A request
+// blocked waiting for a token returns early if its context is canceled.
+func WithRateLimit(rps float64, burst int) Option {
+	limiter := newTokenBucket(rps, burst)
+
+	return WithMiddleware(func(next RoundTripper) RoundTripper {
+		return func(req *http.Request) (*http.Response, error) {
+			if err := limiter.wait(req.Context()); err != nil {
+				return nil, err
+			}
+			return next(req)
+		}
+	})
+}
+
+// tokenBucket is a minimal shared rate limiter: it refills tokens at rps
+// per second, up to burst, and blocks wait callers until one is available.
+type tokenBucket struct {
+	mu         sync.Mutex
+	rps        float64
+	burst      float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newTokenBucket(rps float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rps:        rps,
+		burst:      float64(burst),
+		tokens:     float64(burst),
+		lastRefill: time.Now(),
+	}
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		wait, ok := b.take()
+		if ok {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (b *tokenBucket) take() (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.lastRefill).Seconds() * b.rps
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.lastRefill = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true
+	}
+
+	missing := 1 - b.tokens
+	return time.Duration(missing / b.rps * float64(time.Second)), false
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// RecordMode controls how WithRecorder's cassette file is used.
+type RecordMode int
+
+const (
+	// ModeReplay serves previously recorded interactions from the cassette
+	// and never makes a live request. New returns an error if the cassette
+	// cannot be read.
+	ModeReplay RecordMode = iota
+	// ModeRecord makes live requests and writes every interaction to the
+	// cassette file, overwriting any existing one.
+	ModeRecord
+	// ModePassthrough sends requests live, touching the cassette file
+	// neither to record nor replay.
+	ModePassthrough
+)
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+type cassetteRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int                 `json:"status_code"`
+	Header     map[string][]string `json:"header,omitempty"`
+	Body       string              `json:"body"`
+}
+
+func (i cassetteInteraction) matches(req *http.Request) bool {
+	return i.Request.Method == req.Method && i.Request.URL == req.URL.String()
+}
+
+func (i cassetteInteraction) response() *http.Response {
+	return &http.Response{
+		StatusCode: i.Response.StatusCode,
+		Header:     http.Header(i.Response.Header),
+		Body:       io.NopCloser(strings.NewReader(i.Response.Body)),
+	}
+}
+
+// WithRecorder wraps every request the Client makes in a cassette file at
+// path. ModeRecord makes live requests and appends each one to the cassette;
+// ModeReplay serves the cassette's recorded responses instead of making live
+// requests; ModePassthrough ignores the cassette entirely. This lets tests
+// using the Client run offline and in CI after recording once against the
+// live Anexia Engine API.
+func WithRecorder(path string, mode RecordMode) Option {
+	switch mode {
+	case ModeReplay:
+		return func(c *client) error {
+			cassette, err := loadCassette(path)
+			if err != nil {
+				return fmt.Errorf("could not load cassette %q: %w", path, err)
+			}
+			return WithMiddleware(replayMiddleware(cassette))(c)
+		}
+	case ModeRecord:
+		return WithMiddleware(recordMiddleware(path))
+	default:
+		return func(c *client) error { return nil }
+	}
+}
+
+// replayMiddleware serves interactions matching a request's method and URL,
+// in the order they were recorded, without making any live request. Each
+// interaction is served at most once, so repeating the same request plays
+// back the cassette's successive recordings of it in turn.
+func replayMiddleware(cassette []cassetteInteraction) Middleware {
+	var mu sync.Mutex
+	used := make([]bool, len(cassette))
+
+	return func(_ RoundTripper) RoundTripper {
+		return func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			for i, interaction := range cassette {
+				if used[i] || !interaction.matches(req) {
+					continue
+				}
+				used[i] = true
+				return interaction.response(), nil
+			}
+
+			return nil, fmt.Errorf("recorder: no recorded interaction for %s %s", req.Method, req.URL)
+		}
+	}
+}
+
+func recordMiddleware(path string) Middleware {
+	var mu sync.Mutex
+	var cassette []cassetteInteraction
+
+	return func(next RoundTripper) RoundTripper {
+		return func(req *http.Request) (*http.Response, error) {
+			reqBody, err := readRequestBody(req)
+			if err != nil {
+				return nil, fmt.Errorf("recorder: could not read request body: %w", err)
+			}
+
+			resp, err := next(req)
+			if err != nil {
+				return resp, err
+			}
+
+			respBody, err := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if err != nil {
+				return nil, fmt.Errorf("recorder: could not read response body: %w", err)
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+			header := map[string][]string(resp.Header.Clone())
+
+			mu.Lock()
+			cassette = append(cassette, cassetteInteraction{
+				Request:  cassetteRequest{Method: req.Method, URL: req.URL.String(), Body: string(reqBody)},
+				Response: cassetteResponse{StatusCode: resp.StatusCode, Header: header, Body: string(respBody)},
+			})
+			saveErr := saveCassette(path, cassette)
+			mu.Unlock()
+
+			if saveErr != nil {
+				return nil, fmt.Errorf("recorder: could not save cassette %q: %w", path, saveErr)
+			}
+
+			return resp, nil
+		}
+	}
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.GetBody == nil {
+		return nil, nil
+	}
+
+	rc, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
+
+func saveCassette(path string, cassette []cassetteInteraction) error {
+	data, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+func loadCassette(path string) ([]cassetteInteraction, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cassette []cassetteInteraction
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, err
+	}
+
+	return cassette, nil
+}
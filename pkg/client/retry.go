@@ -0,0 +1,127 @@
+package client
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures WithRetry.
+type RetryPolicy struct {
+	// MaxRetries is the number of additional attempts made after the first
+	// one fails.
+	MaxRetries int
+	// BaseDelay is the backoff delay used for the first retry. It is
+	// doubled for every subsequent attempt, up to MaxDelay.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff delay between retries.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy is used by WithRetry when given a zero-value RetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 5,
+	BaseDelay:  200 * time.Millisecond,
+	MaxDelay:   30 * time.Second,
+}
+
+// WithRetry retries requests that fail with a 429 or 5xx status, using
+// exponential backoff with full jitter between attempts. A Retry-After
+// response header, if present, takes precedence over the computed backoff.
+// Retries stop as soon as the request's context is done, so callers never
+// wait past their own deadline. A zero-value RetryPolicy falls back to
+// DefaultRetryPolicy.
+//
+// A request with a body is only retried if its GetBody func is set (as
+// http.NewRequest does for common body types); otherwise the first attempt
+// has already drained the body, and resending it would silently strip the
+// payload, so the failed response is returned instead.
+func WithRetry(policy RetryPolicy) Option {
+	if policy == (RetryPolicy{}) {
+		policy = DefaultRetryPolicy
+	}
+
+	return WithMiddleware(func(next RoundTripper) RoundTripper {
+		return func(req *http.Request) (*http.Response, error) {
+			var resp *http.Response
+			var err error
+
+			for attempt := 0; ; attempt++ {
+				resp, err = next(req)
+				if err != nil || !shouldRetry(resp) || attempt >= policy.MaxRetries {
+					return resp, err
+				}
+
+				// A request body can only be resent if the standard library
+				// gave us a way to re-read it; otherwise the first attempt
+				// already drained it and a retry would silently send an
+				// empty body.
+				if req.Body != nil && req.Body != http.NoBody {
+					if req.GetBody == nil {
+						return resp, err
+					}
+
+					body, rewindErr := req.GetBody()
+					if rewindErr != nil {
+						resp.Body.Close()
+						return nil, fmt.Errorf("could not rewind request body for retry: %w", rewindErr)
+					}
+					req.Body = body
+				}
+
+				delay := retryDelay(policy, attempt, resp)
+				resp.Body.Close()
+
+				timer := time.NewTimer(delay)
+				select {
+				case <-req.Context().Done():
+					timer.Stop()
+					return nil, req.Context().Err()
+				case <-timer.C:
+				}
+			}
+		}
+	})
+}
+
+func shouldRetry(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+func retryDelay(policy RetryPolicy, attempt int, resp *http.Response) time.Duration {
+	if retryAfter, ok := parseRetryAfter(resp); ok {
+		return retryAfter
+	}
+
+	backoff := policy.BaseDelay << attempt
+	if backoff <= 0 || backoff > policy.MaxDelay {
+		backoff = policy.MaxDelay
+	}
+	// rand.Int63n panics on n <= 0, which a policy with MaxDelay <= 0 would
+	// otherwise reach.
+	if backoff <= 0 {
+		backoff = time.Nanosecond
+	}
+
+	// full jitter: a random delay in [0, backoff)
+	return time.Duration(rand.Int63n(int64(backoff)))
+}
+
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(header); err == nil {
+		return time.Until(when), true
+	}
+
+	return 0, false
+}
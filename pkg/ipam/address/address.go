@@ -8,6 +8,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/anexia-it/go-anxcloud/pkg/client"
 )
 
 const (
@@ -81,6 +85,99 @@ type listResponse struct {
 	} `json:"data"`
 }
 
+// ListOptions controls pagination and server-side filtering for ListFiltered.
+type ListOptions struct {
+	Page  int
+	Limit int
+
+	VlanID      string
+	PrefixID    string
+	Role        string
+	Status      string
+	IPVersion   int
+	Description string
+	Search      string
+}
+
+// ListOption changes settings on a ListOptions.
+type ListOption func(*ListOptions)
+
+// WithPage sets the page to retrieve. Defaults to 1.
+func WithPage(page int) ListOption {
+	return func(o *ListOptions) { o.Page = page }
+}
+
+// WithLimit sets the number of entries per page. Defaults to 1000.
+func WithLimit(limit int) ListOption {
+	return func(o *ListOptions) { o.Limit = limit }
+}
+
+// WithVlanID filters addresses by the VLAN they belong to.
+func WithVlanID(id string) ListOption {
+	return func(o *ListOptions) { o.VlanID = id }
+}
+
+// WithPrefixID filters addresses by the prefix they belong to.
+func WithPrefixID(id string) ListOption {
+	return func(o *ListOptions) { o.PrefixID = id }
+}
+
+// WithRole filters addresses by their role.
+func WithRole(role string) ListOption {
+	return func(o *ListOptions) { o.Role = role }
+}
+
+// WithStatus filters addresses by their status.
+func WithStatus(status string) ListOption {
+	return func(o *ListOptions) { o.Status = status }
+}
+
+// WithIPVersion filters addresses by IP version, e.g. 4 or 6.
+func WithIPVersion(version int) ListOption {
+	return func(o *ListOptions) { o.IPVersion = version }
+}
+
+// WithDescription filters addresses by a substring of their customer
+// description.
+func WithDescription(description string) ListOption {
+	return func(o *ListOptions) { o.Description = description }
+}
+
+// WithSearch performs a free-text search across address fields.
+func WithSearch(search string) ListOption {
+	return func(o *ListOptions) { o.Search = search }
+}
+
+func (o ListOptions) query() url.Values {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(o.Page))
+	values.Set("limit", strconv.Itoa(o.Limit))
+
+	if o.VlanID != "" {
+		values.Set("vlan", o.VlanID)
+	}
+	if o.PrefixID != "" {
+		values.Set("prefix", o.PrefixID)
+	}
+	if o.Role != "" {
+		values.Set("role", o.Role)
+	}
+	if o.Status != "" {
+		values.Set("status", o.Status)
+	}
+	if o.IPVersion != 0 {
+		values.Set("version", strconv.Itoa(o.IPVersion))
+	}
+	if o.Description != "" {
+		values.Set("description_customer", o.Description)
+	}
+	if o.Search != "" {
+		values.Set("search", o.Search)
+	}
+
+	return values
+}
+
 // NewCreate creates a new address definition with required vlaues.
 func NewCreate(prefixID string, address string) Create {
 	return Create{
@@ -90,14 +187,29 @@ func NewCreate(prefixID string, address string) Create {
 	}
 }
 
+// List returns addresses on the given page, limited to the given number of
+// entries. For server-side filtering use ListFiltered instead.
 func (a api) List(ctx context.Context, page, limit int) ([]Summary, error) {
-	url := fmt.Sprintf(
-		"%s%s?page=%v&limit=%v",
+	return a.ListFiltered(ctx, WithPage(page), WithLimit(limit))
+}
+
+// ListFiltered returns addresses matching the given ListOptions, e.g.
+// WithVlanID, WithPrefixID, WithRole, WithStatus, WithIPVersion,
+// WithDescription or WithSearch. Page defaults to 1 and limit to 1000 unless
+// overridden with WithPage and WithLimit.
+func (a api) ListFiltered(ctx context.Context, opts ...ListOption) ([]Summary, error) {
+	options := ListOptions{Page: 1, Limit: 1000}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	requestURL := fmt.Sprintf(
+		"%s%s?%s",
 		a.client.BaseURL(),
-		pathAddressPrefix, page, limit,
+		pathAddressPrefix, options.query().Encode(),
 	)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("could not create address list request: %w", err)
 	}
@@ -106,6 +218,9 @@ func (a api) List(ctx context.Context, page, limit int) ([]Summary, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not execute address list request: %w", err)
 	}
+	if httpResponse.StatusCode >= 300 {
+		return nil, client.DecodeError(httpResponse)
+	}
 	var responsePayload listResponse
 	err = json.NewDecoder(httpResponse.Body).Decode(&responsePayload)
 	_ = httpResponse.Body.Close()
@@ -134,6 +249,9 @@ func (a api) Get(ctx context.Context, id string) (Address, error) {
 	if err != nil {
 		return Address{}, fmt.Errorf("could not execute address get request: %w", err)
 	}
+	if httpResponse.StatusCode >= 300 {
+		return Address{}, client.DecodeError(httpResponse)
+	}
 	var responsePayload Address
 	err = json.NewDecoder(httpResponse.Body).Decode(&responsePayload)
 	_ = httpResponse.Body.Close()
@@ -162,6 +280,9 @@ func (a api) Delete(ctx context.Context, id string) error {
 	if err != nil {
 		return fmt.Errorf("could not execute address delete request: %w", err)
 	}
+	if httpResponse.StatusCode >= 300 {
+		return client.DecodeError(httpResponse)
+	}
 
 	return httpResponse.Body.Close()
 }
@@ -187,6 +308,9 @@ func (a api) Create(ctx context.Context, create Create) (Summary, error) {
 	if err != nil {
 		return Summary{}, fmt.Errorf("could not execute vlan post request: %w", err)
 	}
+	if httpResponse.StatusCode >= 300 {
+		return Summary{}, client.DecodeError(httpResponse)
+	}
 	var summary Summary
 	err = json.NewDecoder(httpResponse.Body).Decode(&summary)
 	_ = httpResponse.Body.Close()
@@ -218,6 +342,9 @@ func (a api) Update(ctx context.Context, id string, update Update) (Summary, err
 	if err != nil {
 		return Summary{}, fmt.Errorf("could not execute vlan update request: %w", err)
 	}
+	if httpResponse.StatusCode >= 300 {
+		return Summary{}, client.DecodeError(httpResponse)
+	}
 	var summary Summary
 	err = json.NewDecoder(httpResponse.Body).Decode(&summary)
 	_ = httpResponse.Body.Close()
@@ -249,6 +376,9 @@ func (a api) ReserveRandom(ctx context.Context, reserve ReserveRandom) (ReserveR
 	if err != nil {
 		return ReserveRandomSummary{}, fmt.Errorf("could not execute IP address reserve random post request: %w", err)
 	}
+	if httpResponse.StatusCode >= 300 {
+		return ReserveRandomSummary{}, client.DecodeError(httpResponse)
+	}
 	var summary ReserveRandomSummary
 	err = json.NewDecoder(httpResponse.Body).Decode(&summary)
 	_ = httpResponse.Body.Close()
@@ -0,0 +1,182 @@
+package address
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/anexia-it/go-anxcloud/pkg/client"
+	clienterrors "github.com/anexia-it/go-anxcloud/pkg/client/errors"
+	"github.com/anexia-it/go-anxcloud/pkg/task"
+)
+
+func newTestAPI(t *testing.T, mux *http.ServeMux) API {
+	mock, closeMock := client.NewMock(mux)
+	t.Cleanup(closeMock)
+	return NewAPI(mock)
+}
+
+func TestList(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathAddressPrefix, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(listResponse{
+			Data: struct {
+				Data []Summary `json:"data"`
+			}{Data: []Summary{{ID: "1", Name: "10.0.0.1"}}},
+		})
+	})
+
+	addresses, err := newTestAPI(t, mux).List(context.Background(), 1, 10)
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(addresses) != 1 || addresses[0].ID != "1" {
+		t.Fatalf("unexpected addresses: %+v", addresses)
+	}
+}
+
+func TestGet(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathAddressPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Address{ID: "1", Name: "10.0.0.1", Status: "Active"})
+	})
+
+	addr, err := newTestAPI(t, mux).Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if addr.ID != "1" || addr.Status != "Active" {
+		t.Fatalf("unexpected address: %+v", addr)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathAddressPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"not found","error_code":"not_found"}`))
+	})
+
+	_, err := newTestAPI(t, mux).Get(context.Background(), "missing")
+	if !errors.Is(err, clienterrors.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestCreateAndUpdate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathAddressPrefix, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Summary{ID: "1", Name: "10.0.0.1"})
+	})
+	mux.HandleFunc(pathAddressPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Summary{ID: "1", Name: "10.0.0.1", DescriptionCustomer: "updated"})
+	})
+
+	api := newTestAPI(t, mux)
+
+	summary, err := api.Create(context.Background(), NewCreate("prefix-1", "10.0.0.1"))
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if summary.ID != "1" {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+
+	updated, err := api.Update(context.Background(), summary.ID, Update{DescriptionCustomer: "updated"})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if updated.DescriptionCustomer != "updated" {
+		t.Fatalf("unexpected summary: %+v", updated)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathAddressPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := newTestAPI(t, mux).Delete(context.Background(), "1"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+}
+
+func TestReserveRandom(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathReserveAddressPrefix, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(ReserveRandomSummary{
+			Data: []ReservedIP{{ID: "1", Address: "10.0.0.1", Prefix: "prefix-1"}},
+		})
+	})
+
+	summary, err := newTestAPI(t, mux).ReserveRandom(context.Background(), ReserveRandom{VlanID: "vlan-1", Count: 1})
+	if err != nil {
+		t.Fatalf("ReserveRandom returned error: %v", err)
+	}
+	if len(summary.Data) != 1 || summary.Data[0].ID != "1" {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+}
+
+func TestReserveAvailableFromPrefix(t *testing.T) {
+	listCalls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathAddressPrefix, func(w http.ResponseWriter, r *http.Request) {
+		listCalls++
+		_ = json.NewEncoder(w).Encode(listResponse{
+			Data: struct {
+				Data []Summary `json:"data"`
+			}{Data: []Summary{{ID: "1", Name: "10.0.0.1"}}},
+		})
+	})
+	mux.HandleFunc(pathAddressPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Address{ID: "1", Name: "10.0.0.1", Status: "Active"})
+	})
+
+	api := newTestAPI(t, mux)
+	request := ReserveAvailableRequest{PrefixID: "prefix-1", Description: "customer-a", RequestToken: "token-1"}
+
+	// free addresses never have a Description of their own, so it must not
+	// be used to filter them out before reservation.
+	reserved, err := api.ReserveAvailable(context.Background(), request)
+	if err != nil {
+		t.Fatalf("ReserveAvailable returned error: %v", err)
+	}
+	if reserved.ID != "1" {
+		t.Fatalf("unexpected address: %+v", reserved)
+	}
+
+	// A second call with the same token must be served from the dedupe
+	// cache instead of reserving another address.
+	if _, err := api.ReserveAvailable(context.Background(), request); err != nil {
+		t.Fatalf("ReserveAvailable returned error: %v", err)
+	}
+	if listCalls != 1 {
+		t.Fatalf("expected 1 list call, got %d", listCalls)
+	}
+}
+
+func TestCreateAndWait(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathAddressPrefix, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Summary{ID: "1", Name: "10.0.0.1"})
+	})
+	mux.HandleFunc(pathAddressPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Address{ID: "1", Name: "10.0.0.1", Status: "Active"})
+	})
+
+	addr, err := newTestAPI(t, mux).CreateAndWait(context.Background(), NewCreate("prefix-1", "10.0.0.1"), task.PollOptions{
+		Interval: time.Millisecond,
+		Timeout:  time.Second,
+	})
+	if err != nil {
+		t.Fatalf("CreateAndWait returned error: %v", err)
+	}
+	if addr.Status != "Active" {
+		t.Fatalf("unexpected address: %+v", addr)
+	}
+}
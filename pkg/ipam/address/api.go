@@ -0,0 +1,34 @@
+package address
+
+import (
+	"context"
+	"sync"
+
+	"github.com/anexia-it/go-anxcloud/pkg/client"
+	"github.com/anexia-it/go-anxcloud/pkg/task"
+)
+
+// API contains methods for managing IPs under /ipam/address.
+type API interface {
+	List(ctx context.Context, page, limit int) ([]Summary, error)
+	ListFiltered(ctx context.Context, opts ...ListOption) ([]Summary, error)
+	Get(ctx context.Context, id string) (Address, error)
+	Create(ctx context.Context, create Create) (Summary, error)
+	Update(ctx context.Context, id string, update Update) (Summary, error)
+	Delete(ctx context.Context, id string) error
+	ReserveRandom(ctx context.Context, reserve ReserveRandom) (ReserveRandomSummary, error)
+	ReserveAvailable(ctx context.Context, request ReserveAvailableRequest) (Address, error)
+	CreateAndWait(ctx context.Context, create Create, opts task.PollOptions) (Address, error)
+}
+
+type api struct {
+	client client.Client
+
+	// reservations deduplicates ReserveAvailable calls by RequestToken.
+	reservations *sync.Map
+}
+
+// NewAPI creates a new address API instance using the given Client.
+func NewAPI(c client.Client) API {
+	return api{client: c, reservations: &sync.Map{}}
+}
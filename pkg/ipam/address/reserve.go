@@ -0,0 +1,128 @@
+package address
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNoAvailableAddress is returned by ReserveAvailable when no free address
+// matching the given criteria could be found in the target prefix.
+var ErrNoAvailableAddress = errors.New("no available address matching the given criteria")
+
+// ReserveAvailableRequest describes the criteria an address reserved by
+// ReserveAvailable has to satisfy.
+type ReserveAvailableRequest struct {
+	// LocationID is required when VlanID is set and PrefixID is not, since
+	// ReserveRandom reserves from a location rather than a specific prefix.
+	LocationID string
+	VlanID     string
+	PrefixID   string
+
+	Role        string
+	Description string
+	IPVersion   int
+
+	// RequestToken makes ReserveAvailable idempotent: calling it again with
+	// the same token returns the previously reserved Address instead of
+	// reserving a new one.
+	RequestToken string
+}
+
+// ReserveAvailable reserves a free address matching the given request. If a
+// VlanID is given without a PrefixID, it delegates to ReserveRandom.
+// Otherwise it lists free addresses in PrefixID matching Role and
+// IPVersion, picks the first one, and reserves it by setting its Role and
+// customer Description. Free addresses have no description of their own, so
+// Description is only ever written, never matched against.
+// ErrNoAvailableAddress is returned when the prefix has no free address
+// matching the criteria.
+func (a api) ReserveAvailable(ctx context.Context, request ReserveAvailableRequest) (Address, error) {
+	if request.RequestToken != "" {
+		if cached, ok := a.reservations.Load(request.RequestToken); ok {
+			return cached.(Address), nil
+		}
+	}
+
+	var reserved Address
+	var err error
+
+	if request.VlanID != "" && request.PrefixID == "" {
+		reserved, err = a.reserveRandomFromVlan(ctx, request)
+	} else {
+		reserved, err = a.reserveFromPrefix(ctx, request)
+	}
+
+	if err != nil {
+		return Address{}, err
+	}
+
+	if request.RequestToken != "" {
+		a.reservations.Store(request.RequestToken, reserved)
+	}
+
+	return reserved, nil
+}
+
+func (a api) reserveRandomFromVlan(ctx context.Context, request ReserveAvailableRequest) (Address, error) {
+	summary, err := a.ReserveRandom(ctx, ReserveRandom{
+		LocationID: request.LocationID,
+		VlanID:     request.VlanID,
+		Count:      1,
+	})
+	if err != nil {
+		return Address{}, fmt.Errorf("could not reserve random address: %w", err)
+	}
+
+	if len(summary.Data) == 0 {
+		return Address{}, ErrNoAvailableAddress
+	}
+
+	reserved, err := a.Get(ctx, summary.Data[0].ID)
+	if err != nil {
+		return Address{}, fmt.Errorf("could not get reserved address: %w", err)
+	}
+
+	if request.Description != "" {
+		updated, err := a.Update(ctx, reserved.ID, Update{DescriptionCustomer: request.Description})
+		if err != nil {
+			return Address{}, fmt.Errorf("could not set description on reserved address: %w", err)
+		}
+		reserved.DescriptionCustomer = updated.DescriptionCustomer
+	}
+
+	return reserved, nil
+}
+
+func (a api) reserveFromPrefix(ctx context.Context, request ReserveAvailableRequest) (Address, error) {
+	free, err := a.ListFiltered(ctx,
+		WithPrefixID(request.PrefixID),
+		WithStatus("Free"),
+		WithRole(request.Role),
+		WithIPVersion(request.IPVersion),
+	)
+	if err != nil {
+		return Address{}, fmt.Errorf("could not list free addresses: %w", err)
+	}
+
+	if len(free) == 0 {
+		return Address{}, ErrNoAvailableAddress
+	}
+	candidate := &free[0]
+
+	update := Update{Role: request.Role}
+	if request.Description != "" {
+		update.DescriptionCustomer = request.Description
+	}
+
+	if _, err := a.Update(ctx, candidate.ID, update); err != nil {
+		return Address{}, fmt.Errorf("could not reserve address: %w", err)
+	}
+
+	reserved, err := a.Get(ctx, candidate.ID)
+	if err != nil {
+		return Address{}, fmt.Errorf("could not get reserved address: %w", err)
+	}
+
+	return reserved, nil
+}
@@ -0,0 +1,36 @@
+package address
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anexia-it/go-anxcloud/pkg/task"
+)
+
+// CreateAndWait creates an address and blocks until it becomes "Active",
+// eliminating the need to poll Get in a loop. It returns
+// task.ErrProvisioningFailed if the address reaches a terminal error status
+// and task.ErrTimeout if opts.Timeout elapses first.
+func (a api) CreateAndWait(ctx context.Context, create Create, opts task.PollOptions) (Address, error) {
+	summary, err := a.Create(ctx, create)
+	if err != nil {
+		return Address{}, fmt.Errorf("could not create address: %w", err)
+	}
+
+	ref := task.ResourceRef{
+		Options: opts,
+		Get: func(ctx context.Context) (string, error) {
+			addr, err := a.Get(ctx, summary.ID)
+			if err != nil {
+				return "", err
+			}
+			return addr.Status, nil
+		},
+	}
+
+	if err := task.WaitForActive(ctx, ref); err != nil {
+		return Address{}, err
+	}
+
+	return a.Get(ctx, summary.ID)
+}
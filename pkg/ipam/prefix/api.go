@@ -0,0 +1,28 @@
+package prefix
+
+import (
+	"context"
+
+	"github.com/anexia-it/go-anxcloud/pkg/client"
+	"github.com/anexia-it/go-anxcloud/pkg/task"
+)
+
+// API contains methods for managing prefixes under /ipam/prefix.
+type API interface {
+	List(ctx context.Context, page, limit int) ([]Summary, error)
+	ListFiltered(ctx context.Context, opts ...ListOption) ([]Summary, error)
+	Get(ctx context.Context, id string) (Info, error)
+	Create(ctx context.Context, create Create) (Summary, error)
+	Update(ctx context.Context, id string, update Update) (Summary, error)
+	Delete(ctx context.Context, id string) error
+	CreateAndWait(ctx context.Context, create Create, opts task.PollOptions) (Info, error)
+}
+
+type api struct {
+	client client.Client
+}
+
+// NewAPI creates a new prefix API instance using the given Client.
+func NewAPI(c client.Client) API {
+	return api{c}
+}
@@ -0,0 +1,328 @@
+// Package prefix implements API functions residing under /ipam/prefix.
+// This path contains methods for managing IP prefixes.
+package prefix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/anexia-it/go-anxcloud/pkg/client"
+)
+
+const (
+	pathPrefix = "/api/ipam/v1/prefix.json"
+
+	// TypePublic marks a prefix as publicly routable.
+	TypePublic = "Public"
+	// TypePrivate marks a prefix as privately routable.
+	TypePrivate = "Private"
+)
+
+// Info contains all the information about a specific prefix.
+type Info struct {
+	ID                  string `json:"identifier"`
+	Name                string `json:"name"`
+	DescriptionCustomer string `json:"description_customer"`
+	DescriptionInternal string `json:"description_internal"`
+	VlanID              string `json:"vlan"`
+	VersionID           int    `json:"version"`
+	Type                string `json:"type"`
+	NetworkMask         int    `json:"netmask"`
+	Status              string `json:"status"`
+}
+
+// Summary is the prefix information returned by a listing.
+type Summary struct {
+	ID                  string `json:"identifier"`
+	Name                string `json:"name"`
+	DescriptionCustomer string `json:"description_customer"`
+	VlanID              string `json:"vlan"`
+}
+
+// Update contains fields to change on a prefix.
+type Update struct {
+	CustomerDescription string `json:"description_customer,omitempty"`
+}
+
+// Create defines meta data of a prefix to create.
+type Create struct {
+	LocationID  string `json:"location_id"`
+	VlanID      string `json:"vlan_id"`
+	IPVersion   int    `json:"ip_version"`
+	Type        string `json:"type"`
+	NetworkMask int    `json:"netmask"`
+}
+
+// NewCreate creates a new prefix definition with required values.
+func NewCreate(locationID, vlanID string, ipVersion int, prefixType string, netmask int) Create {
+	return Create{
+		LocationID:  locationID,
+		VlanID:      vlanID,
+		IPVersion:   ipVersion,
+		Type:        prefixType,
+		NetworkMask: netmask,
+	}
+}
+
+type listResponse struct {
+	Data struct {
+		Data []Summary `json:"data"`
+	} `json:"data"`
+}
+
+// ListOptions controls pagination and server-side filtering for ListFiltered.
+type ListOptions struct {
+	Page  int
+	Limit int
+
+	VlanID      string
+	Role        string
+	Status      string
+	IPVersion   int
+	Description string
+	Search      string
+}
+
+// ListOption changes settings on a ListOptions.
+type ListOption func(*ListOptions)
+
+// WithPage sets the page to retrieve. Defaults to 1.
+func WithPage(page int) ListOption {
+	return func(o *ListOptions) { o.Page = page }
+}
+
+// WithLimit sets the number of entries per page. Defaults to 1000.
+func WithLimit(limit int) ListOption {
+	return func(o *ListOptions) { o.Limit = limit }
+}
+
+// WithVlanID filters prefixes by the VLAN they belong to.
+func WithVlanID(id string) ListOption {
+	return func(o *ListOptions) { o.VlanID = id }
+}
+
+// WithRole filters prefixes by their role.
+func WithRole(role string) ListOption {
+	return func(o *ListOptions) { o.Role = role }
+}
+
+// WithStatus filters prefixes by their status.
+func WithStatus(status string) ListOption {
+	return func(o *ListOptions) { o.Status = status }
+}
+
+// WithIPVersion filters prefixes by IP version, e.g. 4 or 6.
+func WithIPVersion(version int) ListOption {
+	return func(o *ListOptions) { o.IPVersion = version }
+}
+
+// WithDescription filters prefixes by a substring of their customer
+// description.
+func WithDescription(description string) ListOption {
+	return func(o *ListOptions) { o.Description = description }
+}
+
+// WithSearch performs a free-text search across prefix fields.
+func WithSearch(search string) ListOption {
+	return func(o *ListOptions) { o.Search = search }
+}
+
+func (o ListOptions) query() url.Values {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(o.Page))
+	values.Set("limit", strconv.Itoa(o.Limit))
+
+	if o.VlanID != "" {
+		values.Set("vlan", o.VlanID)
+	}
+	if o.Role != "" {
+		values.Set("role", o.Role)
+	}
+	if o.Status != "" {
+		values.Set("status", o.Status)
+	}
+	if o.IPVersion != 0 {
+		values.Set("version", strconv.Itoa(o.IPVersion))
+	}
+	if o.Description != "" {
+		values.Set("description_customer", o.Description)
+	}
+	if o.Search != "" {
+		values.Set("search", o.Search)
+	}
+
+	return values
+}
+
+// List returns prefixes on the given page, limited to the given number of
+// entries. For server-side filtering use ListFiltered instead.
+func (a api) List(ctx context.Context, page, limit int) ([]Summary, error) {
+	return a.ListFiltered(ctx, WithPage(page), WithLimit(limit))
+}
+
+// ListFiltered returns prefixes matching the given ListOptions, e.g.
+// WithVlanID, WithRole, WithStatus, WithIPVersion, WithDescription or
+// WithSearch. Page defaults to 1 and limit to 1000 unless overridden with
+// WithPage and WithLimit.
+func (a api) ListFiltered(ctx context.Context, opts ...ListOption) ([]Summary, error) {
+	options := ListOptions{Page: 1, Limit: 1000}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	requestURL := fmt.Sprintf(
+		"%s%s?%s",
+		a.client.BaseURL(),
+		pathPrefix, options.query().Encode(),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create prefix list request: %w", err)
+	}
+
+	httpResponse, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not execute prefix list request: %w", err)
+	}
+	if httpResponse.StatusCode >= 300 {
+		return nil, client.DecodeError(httpResponse)
+	}
+	var responsePayload listResponse
+	err = json.NewDecoder(httpResponse.Body).Decode(&responsePayload)
+	_ = httpResponse.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("could not decode prefix list response: %w", err)
+	}
+
+	return responsePayload.Data.Data, err
+}
+
+func (a api) Get(ctx context.Context, id string) (Info, error) {
+	requestURL := fmt.Sprintf(
+		"%s%s/%s",
+		a.client.BaseURL(),
+		pathPrefix,
+		id,
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return Info{}, fmt.Errorf("could not create prefix get request: %w", err)
+	}
+
+	httpResponse, err := a.client.Do(req)
+	if err != nil {
+		return Info{}, fmt.Errorf("could not execute prefix get request: %w", err)
+	}
+	if httpResponse.StatusCode >= 300 {
+		return Info{}, client.DecodeError(httpResponse)
+	}
+	var responsePayload Info
+	err = json.NewDecoder(httpResponse.Body).Decode(&responsePayload)
+	_ = httpResponse.Body.Close()
+
+	if err != nil {
+		return Info{}, fmt.Errorf("could not decode prefix get response: %w", err)
+	}
+
+	return responsePayload, err
+}
+
+func (a api) Delete(ctx context.Context, id string) error {
+	requestURL := fmt.Sprintf(
+		"%s%s/%s",
+		a.client.BaseURL(),
+		pathPrefix,
+		id,
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("could not create prefix delete request: %w", err)
+	}
+
+	httpResponse, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not execute prefix delete request: %w", err)
+	}
+	if httpResponse.StatusCode >= 300 {
+		return client.DecodeError(httpResponse)
+	}
+
+	return httpResponse.Body.Close()
+}
+
+func (a api) Create(ctx context.Context, create Create) (Summary, error) {
+	requestURL := fmt.Sprintf(
+		"%s%s",
+		a.client.BaseURL(),
+		pathPrefix,
+	)
+
+	requestData := bytes.Buffer{}
+	if err := json.NewEncoder(&requestData).Encode(create); err != nil {
+		panic(fmt.Sprintf("could not create request data for prefix creation: %v", err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, &requestData)
+	if err != nil {
+		return Summary{}, fmt.Errorf("could not create prefix post request: %w", err)
+	}
+
+	httpResponse, err := a.client.Do(req)
+	if err != nil {
+		return Summary{}, fmt.Errorf("could not execute prefix post request: %w", err)
+	}
+	if httpResponse.StatusCode >= 300 {
+		return Summary{}, client.DecodeError(httpResponse)
+	}
+	var summary Summary
+	err = json.NewDecoder(httpResponse.Body).Decode(&summary)
+	_ = httpResponse.Body.Close()
+	if err != nil {
+		return Summary{}, fmt.Errorf("could not decode prefix post response: %w", err)
+	}
+
+	return summary, nil
+}
+
+func (a api) Update(ctx context.Context, id string, update Update) (Summary, error) {
+	requestURL := fmt.Sprintf(
+		"%s%s/%s",
+		a.client.BaseURL(),
+		pathPrefix, id,
+	)
+
+	requestData := bytes.Buffer{}
+	if err := json.NewEncoder(&requestData).Encode(update); err != nil {
+		panic(fmt.Sprintf("could not create request data for prefix update: %v", err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, requestURL, &requestData)
+	if err != nil {
+		return Summary{}, fmt.Errorf("could not create prefix update request: %w", err)
+	}
+
+	httpResponse, err := a.client.Do(req)
+	if err != nil {
+		return Summary{}, fmt.Errorf("could not execute prefix update request: %w", err)
+	}
+	if httpResponse.StatusCode >= 300 {
+		return Summary{}, client.DecodeError(httpResponse)
+	}
+	var summary Summary
+	err = json.NewDecoder(httpResponse.Body).Decode(&summary)
+	_ = httpResponse.Body.Close()
+	if err != nil {
+		return summary, fmt.Errorf("could not decode prefix update response: %w", err)
+	}
+
+	return summary, err
+}
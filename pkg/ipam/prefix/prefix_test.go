@@ -0,0 +1,105 @@
+package prefix
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/anexia-it/go-anxcloud/pkg/client"
+	clienterrors "github.com/anexia-it/go-anxcloud/pkg/client/errors"
+	"github.com/anexia-it/go-anxcloud/pkg/task"
+)
+
+func newTestAPI(t *testing.T, mux *http.ServeMux) API {
+	mock, closeMock := client.NewMock(mux)
+	t.Cleanup(closeMock)
+	return NewAPI(mock)
+}
+
+func TestList(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathPrefix, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(listResponse{
+			Data: struct {
+				Data []Summary `json:"data"`
+			}{Data: []Summary{{ID: "1", Name: "10.0.0.0/24"}}},
+		})
+	})
+
+	prefixes, err := newTestAPI(t, mux).List(context.Background(), 1, 10)
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(prefixes) != 1 || prefixes[0].ID != "1" {
+		t.Fatalf("unexpected prefixes: %+v", prefixes)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := newTestAPI(t, mux).Get(context.Background(), "missing")
+	if !errors.Is(err, clienterrors.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestCreateUpdateDelete(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathPrefix, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Summary{ID: "1", Name: "10.0.0.0/24"})
+	})
+	mux.HandleFunc(pathPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(Summary{ID: "1", Name: "10.0.0.0/24", DescriptionCustomer: "updated"})
+	})
+
+	api := newTestAPI(t, mux)
+
+	summary, err := api.Create(context.Background(), NewCreate("location-1", "vlan-1", 4, TypePrivate, 24))
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	updated, err := api.Update(context.Background(), summary.ID, Update{CustomerDescription: "updated"})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if updated.DescriptionCustomer != "updated" {
+		t.Fatalf("unexpected summary: %+v", updated)
+	}
+
+	if err := api.Delete(context.Background(), summary.ID); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+}
+
+func TestCreateAndWait(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathPrefix, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Summary{ID: "1", Name: "10.0.0.0/24"})
+	})
+	mux.HandleFunc(pathPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Info{ID: "1", Status: "Active"})
+	})
+
+	info, err := newTestAPI(t, mux).CreateAndWait(context.Background(), NewCreate("location-1", "vlan-1", 4, TypePrivate, 24), task.PollOptions{
+		Interval: time.Millisecond,
+		Timeout:  time.Second,
+	})
+	if err != nil {
+		t.Fatalf("CreateAndWait returned error: %v", err)
+	}
+	if info.Status != "Active" {
+		t.Fatalf("unexpected info: %+v", info)
+	}
+}
@@ -0,0 +1,118 @@
+// Package task provides helpers for waiting on asynchronous resources to
+// reach a target status, replacing the ad-hoc Eventually polling loops
+// consumers of this SDK otherwise have to write by hand.
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// ErrTimeout is returned by WaitForStatus when PollOptions.Timeout elapses
+// before the target status is reached.
+var ErrTimeout = errors.New("task: timed out waiting for target status")
+
+// ErrProvisioningFailed is returned by WaitForStatus when the resource
+// reaches one of PollOptions.TerminalErrorStatuses instead of the target.
+var ErrProvisioningFailed = errors.New("task: resource reached a terminal error status")
+
+const (
+	defaultInterval            = 5 * time.Second
+	defaultTimeout             = 15 * time.Minute
+	defaultTerminalErrorStatus = "Error"
+)
+
+// GetterFunc retrieves the current status of a resource being waited on.
+type GetterFunc func(ctx context.Context) (status string, err error)
+
+// ProgressFunc is called after every poll with the status observed so far.
+type ProgressFunc func(status string)
+
+// PollOptions configures WaitForStatus. The zero value polls every 5
+// seconds, with no jitter, for up to 15 minutes, treating "Error" as the
+// only terminal error status.
+type PollOptions struct {
+	Interval              time.Duration
+	Jitter                time.Duration
+	Timeout               time.Duration
+	TerminalErrorStatuses []string
+	Progress              ProgressFunc
+}
+
+func (o PollOptions) withDefaults() PollOptions {
+	if o.Interval <= 0 {
+		o.Interval = defaultInterval
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = defaultTimeout
+	}
+	if o.TerminalErrorStatuses == nil {
+		o.TerminalErrorStatuses = []string{defaultTerminalErrorStatus}
+	}
+	return o
+}
+
+// WaitForStatus polls get until it reports target, one of
+// opts.TerminalErrorStatuses, or opts.Timeout elapses. It returns
+// ErrProvisioningFailed for the former and ErrTimeout for the latter.
+func WaitForStatus(ctx context.Context, get GetterFunc, target string, opts PollOptions) error {
+	opts = opts.withDefaults()
+
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	for {
+		status, err := get(ctx)
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return ErrTimeout
+			}
+			return fmt.Errorf("could not get resource status: %w", err)
+		}
+
+		if opts.Progress != nil {
+			opts.Progress(status)
+		}
+
+		if status == target {
+			return nil
+		}
+
+		for _, terminal := range opts.TerminalErrorStatuses {
+			if status == terminal {
+				return ErrProvisioningFailed
+			}
+		}
+
+		wait := opts.Interval
+		if opts.Jitter > 0 {
+			wait += time.Duration(rand.Int63n(int64(opts.Jitter)))
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return ErrTimeout
+			}
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// ResourceRef bundles a resource's status getter with the PollOptions to
+// poll it with, so WaitForActive can be called with a single argument.
+type ResourceRef struct {
+	Get     GetterFunc
+	Options PollOptions
+}
+
+// WaitForActive waits for ref to report status "Active".
+func WaitForActive(ctx context.Context, ref ResourceRef) error {
+	return WaitForStatus(ctx, ref.Get, "Active", ref.Options)
+}
@@ -0,0 +1,27 @@
+package vlan
+
+import (
+	"context"
+
+	"github.com/anexia-it/go-anxcloud/pkg/client"
+	"github.com/anexia-it/go-anxcloud/pkg/task"
+)
+
+// API contains methods for managing VLANs under /vlan.
+type API interface {
+	List(ctx context.Context, page, limit int) ([]Summary, error)
+	ListFiltered(ctx context.Context, opts ...ListOption) ([]Summary, error)
+	Get(ctx context.Context, identifier string) (Info, error)
+	Create(ctx context.Context, create CreateDefinition) (Summary, error)
+	Delete(ctx context.Context, identifier string) error
+	CreateAndWait(ctx context.Context, create CreateDefinition, opts task.PollOptions) (Info, error)
+}
+
+type api struct {
+	client client.Client
+}
+
+// NewAPI creates a new VLAN API instance using the given Client.
+func NewAPI(c client.Client) API {
+	return api{c}
+}
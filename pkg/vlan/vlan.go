@@ -0,0 +1,250 @@
+// Package vlan implements API functions residing under /vlan.
+// This path contains methods for managing VLANs.
+package vlan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/anexia-it/go-anxcloud/pkg/client"
+)
+
+const (
+	pathPrefix = "/api/vlan/v1.json"
+)
+
+// Info contains all the information about a specific VLAN.
+type Info struct {
+	Identifier          string `json:"identifier"`
+	Name                string `json:"name"`
+	DescriptionCustomer string `json:"description_customer"`
+	DescriptionInternal string `json:"description_internal"`
+	Role                string `json:"role"`
+	Status              string `json:"status"`
+	LocationID          string `json:"location_id"`
+}
+
+// Summary is the VLAN information returned by a listing or creation.
+type Summary struct {
+	Identifier          string `json:"identifier"`
+	Name                string `json:"name"`
+	DescriptionCustomer string `json:"description_customer"`
+	Status              string `json:"status"`
+}
+
+// CreateDefinition defines meta data of a VLAN to create.
+type CreateDefinition struct {
+	Location            string `json:"location_id"`
+	VM                  string `json:"vm_identifier,omitempty"`
+	CustomerDescription string `json:"description_customer"`
+}
+
+type listResponse struct {
+	Data struct {
+		Data []Summary `json:"data"`
+	} `json:"data"`
+}
+
+// ListOptions controls pagination and server-side filtering for ListFiltered.
+type ListOptions struct {
+	Page  int
+	Limit int
+
+	Role        string
+	Status      string
+	Description string
+	Search      string
+}
+
+// ListOption changes settings on a ListOptions.
+type ListOption func(*ListOptions)
+
+// WithPage sets the page to retrieve. Defaults to 1.
+func WithPage(page int) ListOption {
+	return func(o *ListOptions) { o.Page = page }
+}
+
+// WithLimit sets the number of entries per page. Defaults to 1000.
+func WithLimit(limit int) ListOption {
+	return func(o *ListOptions) { o.Limit = limit }
+}
+
+// WithRole filters VLANs by their role.
+func WithRole(role string) ListOption {
+	return func(o *ListOptions) { o.Role = role }
+}
+
+// WithStatus filters VLANs by their status.
+func WithStatus(status string) ListOption {
+	return func(o *ListOptions) { o.Status = status }
+}
+
+// WithDescription filters VLANs by a substring of their customer
+// description.
+func WithDescription(description string) ListOption {
+	return func(o *ListOptions) { o.Description = description }
+}
+
+// WithSearch performs a free-text search across VLAN fields.
+func WithSearch(search string) ListOption {
+	return func(o *ListOptions) { o.Search = search }
+}
+
+func (o ListOptions) query() url.Values {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(o.Page))
+	values.Set("limit", strconv.Itoa(o.Limit))
+
+	if o.Role != "" {
+		values.Set("role", o.Role)
+	}
+	if o.Status != "" {
+		values.Set("status", o.Status)
+	}
+	if o.Description != "" {
+		values.Set("description_customer", o.Description)
+	}
+	if o.Search != "" {
+		values.Set("search", o.Search)
+	}
+
+	return values
+}
+
+// List returns VLANs on the given page, limited to the given number of
+// entries. For server-side filtering use ListFiltered instead.
+func (a api) List(ctx context.Context, page, limit int) ([]Summary, error) {
+	return a.ListFiltered(ctx, WithPage(page), WithLimit(limit))
+}
+
+// ListFiltered returns VLANs matching the given ListOptions, e.g. WithRole,
+// WithStatus, WithDescription or WithSearch. Page defaults to 1 and limit to
+// 1000 unless overridden with WithPage and WithLimit.
+func (a api) ListFiltered(ctx context.Context, opts ...ListOption) ([]Summary, error) {
+	options := ListOptions{Page: 1, Limit: 1000}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	requestURL := fmt.Sprintf(
+		"%s%s?%s",
+		a.client.BaseURL(),
+		pathPrefix, options.query().Encode(),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create vlan list request: %w", err)
+	}
+
+	httpResponse, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not execute vlan list request: %w", err)
+	}
+	if httpResponse.StatusCode >= 300 {
+		return nil, client.DecodeError(httpResponse)
+	}
+	var responsePayload listResponse
+	err = json.NewDecoder(httpResponse.Body).Decode(&responsePayload)
+	_ = httpResponse.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("could not decode vlan list response: %w", err)
+	}
+
+	return responsePayload.Data.Data, err
+}
+
+func (a api) Get(ctx context.Context, identifier string) (Info, error) {
+	requestURL := fmt.Sprintf(
+		"%s%s/%s",
+		a.client.BaseURL(),
+		pathPrefix,
+		identifier,
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return Info{}, fmt.Errorf("could not create vlan get request: %w", err)
+	}
+
+	httpResponse, err := a.client.Do(req)
+	if err != nil {
+		return Info{}, fmt.Errorf("could not execute vlan get request: %w", err)
+	}
+	if httpResponse.StatusCode >= 300 {
+		return Info{}, client.DecodeError(httpResponse)
+	}
+	var responsePayload Info
+	err = json.NewDecoder(httpResponse.Body).Decode(&responsePayload)
+	_ = httpResponse.Body.Close()
+
+	if err != nil {
+		return Info{}, fmt.Errorf("could not decode vlan get response: %w", err)
+	}
+
+	return responsePayload, err
+}
+
+func (a api) Delete(ctx context.Context, identifier string) error {
+	requestURL := fmt.Sprintf(
+		"%s%s/%s",
+		a.client.BaseURL(),
+		pathPrefix,
+		identifier,
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("could not create vlan delete request: %w", err)
+	}
+
+	httpResponse, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not execute vlan delete request: %w", err)
+	}
+	if httpResponse.StatusCode >= 300 {
+		return client.DecodeError(httpResponse)
+	}
+
+	return httpResponse.Body.Close()
+}
+
+func (a api) Create(ctx context.Context, create CreateDefinition) (Summary, error) {
+	requestURL := fmt.Sprintf(
+		"%s%s",
+		a.client.BaseURL(),
+		pathPrefix,
+	)
+
+	requestData := bytes.Buffer{}
+	if err := json.NewEncoder(&requestData).Encode(create); err != nil {
+		panic(fmt.Sprintf("could not create request data for vlan creation: %v", err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, &requestData)
+	if err != nil {
+		return Summary{}, fmt.Errorf("could not create vlan post request: %w", err)
+	}
+
+	httpResponse, err := a.client.Do(req)
+	if err != nil {
+		return Summary{}, fmt.Errorf("could not execute vlan post request: %w", err)
+	}
+	if httpResponse.StatusCode >= 300 {
+		return Summary{}, client.DecodeError(httpResponse)
+	}
+	var summary Summary
+	err = json.NewDecoder(httpResponse.Body).Decode(&summary)
+	_ = httpResponse.Body.Close()
+	if err != nil {
+		return Summary{}, fmt.Errorf("could not decode vlan post response: %w", err)
+	}
+
+	return summary, nil
+}
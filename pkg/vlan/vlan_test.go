@@ -0,0 +1,93 @@
+package vlan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/anexia-it/go-anxcloud/pkg/client"
+	clienterrors "github.com/anexia-it/go-anxcloud/pkg/client/errors"
+	"github.com/anexia-it/go-anxcloud/pkg/task"
+)
+
+func newTestAPI(t *testing.T, mux *http.ServeMux) API {
+	mock, closeMock := client.NewMock(mux)
+	t.Cleanup(closeMock)
+	return NewAPI(mock)
+}
+
+func TestList(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathPrefix, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(listResponse{
+			Data: struct {
+				Data []Summary `json:"data"`
+			}{Data: []Summary{{Identifier: "1", Name: "vlan-1"}}},
+		})
+	})
+
+	vlans, err := newTestAPI(t, mux).List(context.Background(), 1, 10)
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(vlans) != 1 || vlans[0].Identifier != "1" {
+		t.Fatalf("unexpected vlans: %+v", vlans)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := newTestAPI(t, mux).Get(context.Background(), "missing")
+	if !errors.Is(err, clienterrors.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestCreateAndDelete(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathPrefix, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Summary{Identifier: "1", Name: "vlan-1"})
+	})
+	mux.HandleFunc(pathPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	api := newTestAPI(t, mux)
+
+	summary, err := api.Create(context.Background(), CreateDefinition{Location: "location-1", CustomerDescription: "test"})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	if err := api.Delete(context.Background(), summary.Identifier); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+}
+
+func TestCreateAndWait(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathPrefix, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Summary{Identifier: "1", Name: "vlan-1"})
+	})
+	mux.HandleFunc(pathPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Info{Identifier: "1", Status: "Active"})
+	})
+
+	info, err := newTestAPI(t, mux).CreateAndWait(context.Background(), CreateDefinition{Location: "location-1"}, task.PollOptions{
+		Interval: time.Millisecond,
+		Timeout:  time.Second,
+	})
+	if err != nil {
+		t.Fatalf("CreateAndWait returned error: %v", err)
+	}
+	if info.Status != "Active" {
+		t.Fatalf("unexpected info: %+v", info)
+	}
+}
@@ -0,0 +1,36 @@
+package vlan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anexia-it/go-anxcloud/pkg/task"
+)
+
+// CreateAndWait creates a VLAN and blocks until it becomes "Active",
+// eliminating the need to poll Get in a loop. It returns
+// task.ErrProvisioningFailed if the VLAN reaches a terminal error status and
+// task.ErrTimeout if opts.Timeout elapses first.
+func (a api) CreateAndWait(ctx context.Context, create CreateDefinition, opts task.PollOptions) (Info, error) {
+	summary, err := a.Create(ctx, create)
+	if err != nil {
+		return Info{}, fmt.Errorf("could not create vlan: %w", err)
+	}
+
+	ref := task.ResourceRef{
+		Options: opts,
+		Get: func(ctx context.Context) (string, error) {
+			info, err := a.Get(ctx, summary.Identifier)
+			if err != nil {
+				return "", err
+			}
+			return info.Status, nil
+		},
+	}
+
+	if err := task.WaitForActive(ctx, ref); err != nil {
+		return Info{}, err
+	}
+
+	return a.Get(ctx, summary.Identifier)
+}
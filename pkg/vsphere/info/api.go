@@ -0,0 +1,30 @@
+package info
+
+import (
+	"context"
+
+	"github.com/anexia-it/go-anxcloud/pkg/client"
+	"github.com/anexia-it/go-anxcloud/pkg/task"
+)
+
+// API contains methods for querying information about created VMs under
+// /vsphere/info.
+type API interface {
+	Get(ctx context.Context, identifier string) (Info, error)
+
+	// WaitForActive blocks until the VM identified by identifier reaches
+	// status "Active". The vsphere provisioning API that creates VMs lives
+	// outside this package; pkg/vsphere.ProvisionAndWait calls this once
+	// the VM has been requested, the same way address, prefix and vlan's
+	// CreateAndWait do.
+	WaitForActive(ctx context.Context, identifier string, opts task.PollOptions) (Info, error)
+}
+
+type api struct {
+	client client.Client
+}
+
+// NewAPI creates a new VM info API instance using the given Client.
+func NewAPI(c client.Client) API {
+	return api{c}
+}
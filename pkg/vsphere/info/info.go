@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"github.com/anexia-it/go-anxcloud/pkg/client"
 )
 
 const (
@@ -70,6 +72,9 @@ func (a api) Get(ctx context.Context, identifier string) (Info, error) {
 	if err != nil {
 		return Info{}, fmt.Errorf("could not execute VM info request: %w", err)
 	}
+	if httpResponse.StatusCode >= 300 {
+		return Info{}, client.DecodeError(httpResponse)
+	}
 	var responsePayload Info
 	err = json.NewDecoder(httpResponse.Body).Decode(&responsePayload)
 	_ = httpResponse.Body.Close()
@@ -0,0 +1,65 @@
+package info
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/anexia-it/go-anxcloud/pkg/client"
+	clienterrors "github.com/anexia-it/go-anxcloud/pkg/client/errors"
+	"github.com/anexia-it/go-anxcloud/pkg/task"
+)
+
+func newTestAPI(t *testing.T, mux *http.ServeMux) API {
+	mock, closeMock := client.NewMock(mux)
+	t.Cleanup(closeMock)
+	return NewAPI(mock)
+}
+
+func TestGet(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Info{Identifier: "1", Name: "vm-1", Status: "Active"})
+	})
+
+	info, err := newTestAPI(t, mux).Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if info.Identifier != "1" || info.Status != "Active" {
+		t.Fatalf("unexpected info: %+v", info)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := newTestAPI(t, mux).Get(context.Background(), "missing")
+	if !errors.Is(err, clienterrors.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestWaitForActive(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathPrefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Info{Identifier: "1", Status: "Active"})
+	})
+
+	info, err := newTestAPI(t, mux).WaitForActive(context.Background(), "1", task.PollOptions{
+		Interval: time.Millisecond,
+		Timeout:  time.Second,
+	})
+	if err != nil {
+		t.Fatalf("WaitForActive returned error: %v", err)
+	}
+	if info.Status != "Active" {
+		t.Fatalf("unexpected info: %+v", info)
+	}
+}
@@ -0,0 +1,29 @@
+package info
+
+import (
+	"context"
+
+	"github.com/anexia-it/go-anxcloud/pkg/task"
+)
+
+// WaitForActive blocks until the VM identified by identifier becomes
+// "Active", returning task.ErrProvisioningFailed if it reaches a terminal
+// error status and task.ErrTimeout if opts.Timeout elapses first.
+func (a api) WaitForActive(ctx context.Context, identifier string, opts task.PollOptions) (Info, error) {
+	ref := task.ResourceRef{
+		Options: opts,
+		Get: func(ctx context.Context) (string, error) {
+			info, err := a.Get(ctx, identifier)
+			if err != nil {
+				return "", err
+			}
+			return info.Status, nil
+		},
+	}
+
+	if err := task.WaitForActive(ctx, ref); err != nil {
+		return Info{}, err
+	}
+
+	return a.Get(ctx, identifier)
+}
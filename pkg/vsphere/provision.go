@@ -0,0 +1,31 @@
+// Package vsphere ties VM provisioning to pkg/vsphere/info, mirroring the
+// CreateAndWait convenience methods address, prefix and vlan provide.
+package vsphere
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anexia-it/go-anxcloud/pkg/task"
+	"github.com/anexia-it/go-anxcloud/pkg/vsphere/info"
+)
+
+// ProvisionFunc requests a new VM and returns its identifier once the
+// Engine API has accepted the request. The concrete provisioning API this
+// calls isn't part of pkg/vsphere/info, so ProvisionAndWait takes it as a
+// parameter instead of depending on it directly.
+type ProvisionFunc func(ctx context.Context) (identifier string, err error)
+
+// ProvisionAndWait calls provision to request a VM, then blocks until
+// infoAPI reports it as "Active", the same way address, prefix and vlan's
+// CreateAndWait wait on their own resources. It returns
+// task.ErrProvisioningFailed if the VM reaches a terminal error status and
+// task.ErrTimeout if opts.Timeout elapses first.
+func ProvisionAndWait(ctx context.Context, infoAPI info.API, provision ProvisionFunc, opts task.PollOptions) (info.Info, error) {
+	identifier, err := provision(ctx)
+	if err != nil {
+		return info.Info{}, fmt.Errorf("could not provision VM: %w", err)
+	}
+
+	return infoAPI.WaitForActive(ctx, identifier, opts)
+}
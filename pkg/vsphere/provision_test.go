@@ -0,0 +1,54 @@
+package vsphere
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/anexia-it/go-anxcloud/pkg/client"
+	"github.com/anexia-it/go-anxcloud/pkg/task"
+	"github.com/anexia-it/go-anxcloud/pkg/vsphere/info"
+)
+
+func TestProvisionAndWait(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/vsphere/v1/info.json/", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(info.Info{Identifier: "1", Status: "Active"})
+	})
+
+	mock, closeMock := client.NewMock(mux)
+	t.Cleanup(closeMock)
+	infoAPI := info.NewAPI(mock)
+	provision := func(ctx context.Context) (string, error) {
+		return "1", nil
+	}
+
+	result, err := ProvisionAndWait(context.Background(), infoAPI, provision, task.PollOptions{
+		Interval: time.Millisecond,
+		Timeout:  time.Second,
+	})
+	if err != nil {
+		t.Fatalf("ProvisionAndWait returned error: %v", err)
+	}
+	if result.Identifier != "1" || result.Status != "Active" {
+		t.Fatalf("unexpected info: %+v", result)
+	}
+}
+
+func TestProvisionAndWaitProvisionError(t *testing.T) {
+	mock, closeMock := client.NewMock(http.NewServeMux())
+	t.Cleanup(closeMock)
+	infoAPI := info.NewAPI(mock)
+	provisionErr := errors.New("quota exceeded")
+	provision := func(ctx context.Context) (string, error) {
+		return "", provisionErr
+	}
+
+	_, err := ProvisionAndWait(context.Background(), infoAPI, provision, task.PollOptions{})
+	if !errors.Is(err, provisionErr) {
+		t.Fatalf("expected provision error, got %v", err)
+	}
+}
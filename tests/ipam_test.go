@@ -2,6 +2,7 @@ package tests_test
 
 import (
 	"context"
+	"os"
 	"time"
 
 	"github.com/anexia-it/go-anxcloud/pkg/client"
@@ -17,8 +18,12 @@ var _ = Describe("IPAM API endpoint tests", func() {
 	var cli client.Client
 
 	BeforeEach(func() {
+		if os.Getenv("ANEXIA_TOKEN") == "" {
+			Skip("ANEXIA_TOKEN not set, skipping integration test")
+		}
+
 		var err error
-		cli, err = client.New(client.AuthFromEnv(false))
+		cli, err = client.New(client.AuthFromEnv(true))
 		Expect(err).ToNot(HaveOccurred())
 	})
 